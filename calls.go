@@ -0,0 +1,148 @@
+package twilio
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// CallService gives access to the Calls resource, for making and retrieving
+// phone calls.
+type CallService struct {
+	client *Client
+}
+
+// Call represents a single phone call.
+type Call struct {
+	Sid         string         `json:"sid"`
+	AccountSid  string         `json:"account_sid"`
+	To          PhoneNumber    `json:"to"`
+	From        PhoneNumber    `json:"from"`
+	Status      Status         `json:"status"`
+	AnsweredBy  AnsweredBy     `json:"answered_by"`
+	Direction   string         `json:"direction"`
+	Duration    TwilioDuration `json:"duration"`
+	Price       string         `json:"price"`
+	PriceUnit   string         `json:"price_unit"`
+	StartTime   TwilioTime     `json:"start_time"`
+	EndTime     TwilioTime     `json:"end_time"`
+	DateCreated TwilioTime     `json:"date_created"`
+	DateUpdated TwilioTime     `json:"date_updated"`
+	URI         string         `json:"uri"`
+}
+
+// CallParams holds the optional parameters accepted when creating or
+// updating a Call.
+type CallParams struct {
+	URL            string
+	Method         string
+	StatusCallback string
+	Timeout        int
+	Record         bool
+}
+
+func (p CallParams) values() url.Values {
+	v := url.Values{}
+	if p.URL != "" {
+		v.Set("Url", p.URL)
+	}
+	if p.Method != "" {
+		v.Set("Method", p.Method)
+	}
+	if p.StatusCallback != "" {
+		v.Set("StatusCallback", p.StatusCallback)
+	}
+	if p.Timeout != 0 {
+		v.Set("Timeout", strconv.Itoa(p.Timeout))
+	}
+	if p.Record {
+		v.Set("Record", "true")
+	}
+	return v
+}
+
+// Create places a new call from the "from" number to the "to" number,
+// playing or executing the TwiML found at params.URL.
+func (s *CallService) Create(from, to PhoneNumber, params CallParams) (*Call, error) {
+	v := params.values()
+	v.Set("From", string(from))
+	v.Set("To", string(to))
+	call := new(Call)
+	err := s.client.request(http.MethodPost, "Calls.json", v, call)
+	return call, err
+}
+
+// MakeCall is a convenience wrapper around Create for placing a call that
+// executes the TwiML found at twimlURL.
+func (s *CallService) MakeCall(from, to PhoneNumber, twimlURL string) (*Call, error) {
+	return s.Create(from, to, CallParams{URL: twimlURL})
+}
+
+// Get fetches the call with the given sid.
+func (s *CallService) Get(sid string) (*Call, error) {
+	call := new(Call)
+	err := s.client.request(http.MethodGet, "Calls/"+sid+".json", nil, call)
+	return call, err
+}
+
+// Update modifies the call with the given sid, for example to redirect a
+// live call to new TwiML or to hang it up.
+func (s *CallService) Update(sid string, params CallParams) (*Call, error) {
+	call := new(Call)
+	err := s.client.request(http.MethodPost, "Calls/"+sid+".json", params.values(), call)
+	return call, err
+}
+
+// Delete removes the call with the given sid from the account's call log.
+func (s *CallService) Delete(sid string) error {
+	return s.client.request(http.MethodDelete, "Calls/"+sid+".json", nil, nil)
+}
+
+// CallPage is a single page of results from the Calls list resource.
+type CallPage struct {
+	Page
+	Calls []*Call `json:"calls"`
+}
+
+// GetPage fetches a single page of calls matching params.
+func (s *CallService) GetPage(params url.Values) (*CallPage, error) {
+	page := new(CallPage)
+	err := s.client.request(http.MethodGet, "Calls.json", params, page)
+	return page, err
+}
+
+// Iter returns an iterator over every call matching params, following
+// Twilio's next_page_uri cursors one page at a time.
+func (s *CallService) Iter(params url.Values) *CallPageIterator {
+	return &CallPageIterator{client: s.client, params: params}
+}
+
+// CallPageIterator walks the Calls list resource page by page.
+type CallPageIterator struct {
+	client      *Client
+	params      url.Values
+	nextPageURI string
+	started     bool
+}
+
+// Next fetches the next page of calls, or io.EOF once the list is
+// exhausted.
+func (it *CallPageIterator) Next() (*CallPage, error) {
+	if it.started && it.nextPageURI == "" {
+		return nil, io.EOF
+	}
+	page := new(CallPage)
+	var err error
+	if !it.started {
+		err = it.client.request(http.MethodGet, "Calls.json", it.params, page)
+	} else {
+		err = it.client.requestURL(http.MethodGet, it.client.baseURL()+it.nextPageURI, nil, page)
+	}
+	if err != nil {
+		return nil, err
+	}
+	it.started = true
+	it.nextPageURI = page.NextPageURI
+	return page, nil
+}
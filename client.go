@@ -0,0 +1,142 @@
+package twilio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+const defaultBaseURL = "https://api.twilio.com"
+const apiVersion = "2010-04-01"
+
+// Client is a Twilio REST API client scoped to a single account. Use
+// NewClient or NewClientFromEnv to construct one; the zero value is not
+// usable.
+type Client struct {
+	AccountSid string
+	AuthToken  string
+	BaseURL    string
+	HTTPClient *http.Client
+
+	Messages             *MessageService
+	Calls                *CallService
+	IncomingPhoneNumbers *IncomingPhoneNumberService
+}
+
+// NewClient returns a Client that authenticates with the given account sid
+// and auth token, both found on the console dashboard at
+// https://www.twilio.com/console.
+func NewClient(accountSid, authToken string) *Client {
+	c := &Client{
+		AccountSid: accountSid,
+		AuthToken:  authToken,
+		BaseURL:    defaultBaseURL,
+		HTTPClient: http.DefaultClient,
+	}
+	c.Messages = &MessageService{client: c}
+	c.Calls = &CallService{client: c}
+	c.IncomingPhoneNumbers = &IncomingPhoneNumberService{client: c}
+	return c
+}
+
+// NewClientFromEnv returns a Client configured from the TWILIO_ACCOUNT_SID
+// and TWILIO_AUTH_TOKEN environment variables, returning an error if either
+// is unset.
+func NewClientFromEnv() (*Client, error) {
+	sid := os.Getenv("TWILIO_ACCOUNT_SID")
+	token := os.Getenv("TWILIO_AUTH_TOKEN")
+	if sid == "" || token == "" {
+		return nil, errors.New("twilio: TWILIO_ACCOUNT_SID and TWILIO_AUTH_TOKEN must both be set")
+	}
+	return NewClient(sid, token), nil
+}
+
+// RestError is the typed form of the error body Twilio's REST API returns
+// alongside 4xx and 5xx responses.
+type RestError struct {
+	Code       int    `json:"code"`
+	Message    string `json:"message"`
+	MoreInfo   string `json:"more_info"`
+	StatusCode int    `json:"status"`
+}
+
+func (e *RestError) Error() string {
+	return fmt.Sprintf("twilio: %s (status %d, code %d, see %s)", e.Message, e.StatusCode, e.Code, e.MoreInfo)
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func (c *Client) baseURL() string {
+	if c.BaseURL != "" {
+		return c.BaseURL
+	}
+	return defaultBaseURL
+}
+
+// request issues an authenticated request against a resource path relative
+// to the account's API version root, decoding the JSON response body into v
+// if it is non-nil. A non-2xx response is returned as a *RestError.
+func (c *Client) request(method, path string, form url.Values, v interface{}) error {
+	endpoint := c.baseURL() + "/" + apiVersion + "/Accounts/" + c.AccountSid + "/" + path
+	return c.requestURL(method, endpoint, form, v)
+}
+
+// requestURL issues an authenticated request against a fully-qualified
+// endpoint, such as one built from a Page's NextPageURI.
+func (c *Client) requestURL(method, endpoint string, form url.Values, v interface{}) error {
+	var body io.Reader
+	if form != nil {
+		if method == http.MethodGet {
+			endpoint += "?" + form.Encode()
+		} else {
+			body = strings.NewReader(form.Encode())
+		}
+	}
+	req, err := http.NewRequest(method, endpoint, body)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.AccountSid, c.AuthToken)
+	if method != http.MethodGet {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		restErr := new(RestError)
+		if jsonErr := json.Unmarshal(b, restErr); jsonErr != nil || restErr.Message == "" {
+			return fmt.Errorf("twilio: %s: %s", resp.Status, string(b))
+		}
+		return restErr
+	}
+	if v == nil || len(b) == 0 {
+		return nil
+	}
+	return json.Unmarshal(b, v)
+}
+
+// Page holds the paging metadata common to every Twilio list response.
+type Page struct {
+	Start       uint   `json:"start"`
+	End         uint   `json:"end"`
+	PageSize    uint   `json:"page_size"`
+	NextPageURI string `json:"next_page_uri"`
+}
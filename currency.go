@@ -0,0 +1,119 @@
+package twilio
+
+import "strings"
+
+// currencySymbols maps ISO 4217 currency codes to their conventional
+// symbols. A code with no common symbol falls back to the code itself.
+var currencySymbols = map[string]string{
+	"USD": "$", "GBP": "£", "JPY": "¥", "EUR": "€", "CNY": "¥", "CHF": "CHF",
+	"CAD": "$", "MXN": "$", "SGD": "$", "AUD": "$", "NZD": "$", "HKD": "$",
+	"INR": "₹", "BRL": "R$", "ZAR": "R", "SEK": "kr", "NOK": "kr", "DKK": "kr",
+	"PLN": "zł", "TRY": "₺", "RUB": "₽", "KRW": "₩", "THB": "฿", "IDR": "Rp",
+	"MYR": "RM", "PHP": "₱", "VND": "₫", "ILS": "₪", "AED": "د.إ", "SAR": "﷼",
+	"TWD": "NT$", "CZK": "Kč", "HUF": "Ft", "RON": "lei", "ISK": "kr",
+	"CLP": "$", "COP": "$", "ARS": "$", "PEN": "S/", "UAH": "₴", "EGP": "£",
+	"NGN": "₦", "PKR": "₨", "BDT": "৳", "VEF": "Bs", "KWD": "د.ك", "QAR": "﷼",
+}
+
+// Locale controls how FormatPrice groups digits and separates the integer
+// and fractional parts of an amount.
+type Locale struct {
+	DecimalSeparator string
+	GroupSeparator   string
+}
+
+// LocaleUS formats amounts the way the United States does, e.g.
+// "$1,234.56".
+var LocaleUS = Locale{DecimalSeparator: ".", GroupSeparator: ","}
+
+// LocaleEU formats amounts the way most of continental Europe does, e.g.
+// "€1.234,56".
+var LocaleEU = Locale{DecimalSeparator: ",", GroupSeparator: "."}
+
+type priceOptions struct {
+	keepSign bool
+	locale   Locale
+}
+
+// PriceOption configures FormatPrice.
+type PriceOption func(*priceOptions)
+
+// KeepSign disables FormatPrice's default behavior of flipping the sign of
+// the amount. Twilio reports costs as negative numbers; by default
+// FormatPrice flips them positive for display, which hides that fact.
+func KeepSign() PriceOption {
+	return func(o *priceOptions) { o.keepSign = true }
+}
+
+// WithLocale sets the grouping and decimal separators FormatPrice uses. If
+// this option isn't given, FormatPrice uses LocaleUS.
+func WithLocale(l Locale) PriceOption {
+	return func(o *priceOptions) { o.locale = l }
+}
+
+// FormatPrice formats amount, a decimal string as Twilio returns it (e.g.
+// "-0.00750"), as a human-readable price for the given ISO 4217 currency
+// unit, such as "USD" or "EUR". By default it flips the sign of the amount,
+// since Twilio reports costs as negative numbers - pass KeepSign to
+// preserve the original sign instead. Pass WithLocale to control digit
+// grouping and the decimal separator.
+func FormatPrice(unit, amount string, opts ...PriceOption) string {
+	o := priceOptions{locale: LocaleUS}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if len(amount) == 0 {
+		return amount
+	}
+	negative := amount[0] == '-'
+	if negative || amount[0] == '+' {
+		amount = amount[1:]
+	}
+	if !o.keepSign {
+		negative = !negative
+	}
+
+	integer, fraction := amount, ""
+	if i := strings.IndexByte(amount, '.'); i >= 0 {
+		integer, fraction = amount[:i], amount[i+1:]
+	}
+	numeric := groupDigits(integer, o.locale.GroupSeparator)
+	if fraction != "" {
+		numeric += o.locale.DecimalSeparator + fraction
+	}
+
+	unit = strings.ToUpper(unit)
+	var formatted string
+	switch {
+	case currencySymbols[unit] != "":
+		formatted = currencySymbols[unit] + numeric
+	case unit == "":
+		formatted = numeric
+	default:
+		formatted = unit + " " + numeric
+	}
+	if negative {
+		formatted = "-" + formatted
+	}
+	return formatted
+}
+
+// groupDigits inserts sep every three digits from the right of integer. An
+// empty sep, or an integer part of three digits or fewer, disables
+// grouping.
+func groupDigits(integer, sep string) string {
+	if sep == "" || len(integer) <= 3 {
+		return integer
+	}
+	var b strings.Builder
+	lead := len(integer) % 3
+	if lead == 0 {
+		lead = 3
+	}
+	b.WriteString(integer[:lead])
+	for i := lead; i < len(integer); i += 3 {
+		b.WriteString(sep)
+		b.WriteString(integer[i : i+3])
+	}
+	return b.String()
+}
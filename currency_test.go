@@ -0,0 +1,39 @@
+package twilio
+
+import "testing"
+
+func TestFormatPrice(t *testing.T) {
+	tests := []struct {
+		name   string
+		unit   string
+		amount string
+		opts   []PriceOption
+		want   string
+	}{
+		{"flips sign by default", "USD", "-1.25", nil, "$1.25"},
+		{"flips a positive amount negative", "USD", "1.25", nil, "-$1.25"},
+		{"keeps sign when asked", "USD", "-1.25", []PriceOption{KeepSign()}, "-$1.25"},
+		{"keeps a positive amount positive", "USD", "1.25", []PriceOption{KeepSign()}, "$1.25"},
+		{"sign goes before the symbol, not between it and the digits", "EUR", "-1234.56",
+			[]PriceOption{WithLocale(LocaleEU), KeepSign()}, "-€1.234,56"},
+		{"unknown unit falls back to the code", "XYZ", "-1.25", nil, "XYZ 1.25"},
+		{"empty unit returns the bare number", "", "-1.25", nil, "1.25"},
+		{"preserves trailing zeros", "USD", "-1.50", nil, "$1.50"},
+		{"US locale groups thousands with commas", "USD", "-1234567.89", nil, "$1,234,567.89"},
+		{"empty amount passes through unchanged", "USD", "", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatPrice(tt.unit, tt.amount, tt.opts...)
+			if got != tt.want {
+				t.Errorf("FormatPrice(%q, %q) = %q, want %q", tt.unit, tt.amount, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPriceBackwardsCompat(t *testing.T) {
+	if got := price("USD", "-1.25"); got != "$1.25" {
+		t.Errorf("price(%q, %q) = %q, want %q", "USD", "-1.25", got, "$1.25")
+	}
+}
@@ -0,0 +1,146 @@
+package twilio
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// IncomingPhoneNumberService gives access to the IncomingPhoneNumbers
+// resource, for provisioning and configuring phone numbers on the account.
+type IncomingPhoneNumberService struct {
+	client *Client
+}
+
+// IncomingPhoneNumber represents a phone number provisioned on the account.
+type IncomingPhoneNumber struct {
+	Sid          string      `json:"sid"`
+	AccountSid   string      `json:"account_sid"`
+	FriendlyName string      `json:"friendly_name"`
+	PhoneNumber  PhoneNumber `json:"phone_number"`
+	VoiceURL     string      `json:"voice_url"`
+	VoiceMethod  string      `json:"voice_method"`
+	SMSURL       string      `json:"sms_url"`
+	SMSMethod    string      `json:"sms_method"`
+	DateCreated  TwilioTime  `json:"date_created"`
+	DateUpdated  TwilioTime  `json:"date_updated"`
+	URI          string      `json:"uri"`
+}
+
+// IncomingPhoneNumberParams holds the optional parameters accepted when
+// provisioning or updating an IncomingPhoneNumber.
+type IncomingPhoneNumberParams struct {
+	PhoneNumber  PhoneNumber
+	AreaCode     string
+	FriendlyName string
+	VoiceURL     string
+	VoiceMethod  string
+	SMSURL       string
+	SMSMethod    string
+}
+
+func (p IncomingPhoneNumberParams) values() url.Values {
+	v := url.Values{}
+	if p.PhoneNumber != "" {
+		v.Set("PhoneNumber", string(p.PhoneNumber))
+	}
+	if p.AreaCode != "" {
+		v.Set("AreaCode", p.AreaCode)
+	}
+	if p.FriendlyName != "" {
+		v.Set("FriendlyName", p.FriendlyName)
+	}
+	if p.VoiceURL != "" {
+		v.Set("VoiceUrl", p.VoiceURL)
+	}
+	if p.VoiceMethod != "" {
+		v.Set("VoiceMethod", p.VoiceMethod)
+	}
+	if p.SMSURL != "" {
+		v.Set("SmsUrl", p.SMSURL)
+	}
+	if p.SMSMethod != "" {
+		v.Set("SmsMethod", p.SMSMethod)
+	}
+	return v
+}
+
+// Create provisions a new incoming phone number, either a specific number
+// given in params.PhoneNumber or the first available number matching
+// params.AreaCode.
+func (s *IncomingPhoneNumberService) Create(params IncomingPhoneNumberParams) (*IncomingPhoneNumber, error) {
+	num := new(IncomingPhoneNumber)
+	err := s.client.request(http.MethodPost, "IncomingPhoneNumbers.json", params.values(), num)
+	return num, err
+}
+
+// Get fetches the incoming phone number with the given sid.
+func (s *IncomingPhoneNumberService) Get(sid string) (*IncomingPhoneNumber, error) {
+	num := new(IncomingPhoneNumber)
+	err := s.client.request(http.MethodGet, "IncomingPhoneNumbers/"+sid+".json", nil, num)
+	return num, err
+}
+
+// Update modifies the incoming phone number with the given sid, for example
+// to change the webhook URLs Twilio requests for inbound calls or messages.
+func (s *IncomingPhoneNumberService) Update(sid string, params IncomingPhoneNumberParams) (*IncomingPhoneNumber, error) {
+	num := new(IncomingPhoneNumber)
+	err := s.client.request(http.MethodPost, "IncomingPhoneNumbers/"+sid+".json", params.values(), num)
+	return num, err
+}
+
+// Delete releases the incoming phone number with the given sid back to
+// Twilio.
+func (s *IncomingPhoneNumberService) Delete(sid string) error {
+	return s.client.request(http.MethodDelete, "IncomingPhoneNumbers/"+sid+".json", nil, nil)
+}
+
+// IncomingPhoneNumberPage is a single page of results from the
+// IncomingPhoneNumbers list resource.
+type IncomingPhoneNumberPage struct {
+	Page
+	IncomingPhoneNumbers []*IncomingPhoneNumber `json:"incoming_phone_numbers"`
+}
+
+// GetPage fetches a single page of incoming phone numbers matching params.
+func (s *IncomingPhoneNumberService) GetPage(params url.Values) (*IncomingPhoneNumberPage, error) {
+	page := new(IncomingPhoneNumberPage)
+	err := s.client.request(http.MethodGet, "IncomingPhoneNumbers.json", params, page)
+	return page, err
+}
+
+// Iter returns an iterator over every incoming phone number matching
+// params, following Twilio's next_page_uri cursors one page at a time.
+func (s *IncomingPhoneNumberService) Iter(params url.Values) *IncomingPhoneNumberPageIterator {
+	return &IncomingPhoneNumberPageIterator{client: s.client, params: params}
+}
+
+// IncomingPhoneNumberPageIterator walks the IncomingPhoneNumbers list
+// resource page by page.
+type IncomingPhoneNumberPageIterator struct {
+	client      *Client
+	params      url.Values
+	nextPageURI string
+	started     bool
+}
+
+// Next fetches the next page of incoming phone numbers, or io.EOF once the
+// list is exhausted.
+func (it *IncomingPhoneNumberPageIterator) Next() (*IncomingPhoneNumberPage, error) {
+	if it.started && it.nextPageURI == "" {
+		return nil, io.EOF
+	}
+	page := new(IncomingPhoneNumberPage)
+	var err error
+	if !it.started {
+		err = it.client.request(http.MethodGet, "IncomingPhoneNumbers.json", it.params, page)
+	} else {
+		err = it.client.requestURL(http.MethodGet, it.client.baseURL()+it.nextPageURI, nil, page)
+	}
+	if err != nil {
+		return nil, err
+	}
+	it.started = true
+	it.nextPageURI = page.NextPageURI
+	return page, nil
+}
@@ -0,0 +1,147 @@
+package twilio
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// MessageService gives access to the Messages resource, for sending and
+// retrieving SMS and MMS messages.
+type MessageService struct {
+	client *Client
+}
+
+// Message represents a single SMS or MMS message.
+type Message struct {
+	Sid         string      `json:"sid"`
+	AccountSid  string      `json:"account_sid"`
+	To          PhoneNumber `json:"to"`
+	From        PhoneNumber `json:"from"`
+	Body        string      `json:"body"`
+	Status      Status      `json:"status"`
+	Direction   string      `json:"direction"`
+	NumSegments Segments    `json:"num_segments"`
+	NumMedia    NumMedia    `json:"num_media"`
+	Price       string      `json:"price"`
+	PriceUnit   string      `json:"price_unit"`
+	DateCreated TwilioTime  `json:"date_created"`
+	DateUpdated TwilioTime  `json:"date_updated"`
+	DateSent    TwilioTime  `json:"date_sent"`
+	URI         string      `json:"uri"`
+}
+
+// MessageParams holds the optional parameters accepted when creating or
+// updating a Message.
+type MessageParams struct {
+	Body           string
+	MediaURL       []string
+	StatusCallback string
+	ApplicationSid string
+}
+
+func (p MessageParams) values() url.Values {
+	v := url.Values{}
+	if p.Body != "" {
+		v.Set("Body", p.Body)
+	}
+	for _, m := range p.MediaURL {
+		v.Add("MediaUrl", m)
+	}
+	if p.StatusCallback != "" {
+		v.Set("StatusCallback", p.StatusCallback)
+	}
+	if p.ApplicationSid != "" {
+		v.Set("ApplicationSid", p.ApplicationSid)
+	}
+	return v
+}
+
+// Create sends a new message from the "from" number to the "to" number.
+func (s *MessageService) Create(from, to PhoneNumber, params MessageParams) (*Message, error) {
+	v := params.values()
+	v.Set("From", string(from))
+	v.Set("To", string(to))
+	msg := new(Message)
+	err := s.client.request(http.MethodPost, "Messages.json", v, msg)
+	return msg, err
+}
+
+// SendSMS is a convenience wrapper around Create for a plain text message.
+func (s *MessageService) SendSMS(from, to PhoneNumber, body string) (*Message, error) {
+	return s.Create(from, to, MessageParams{Body: body})
+}
+
+// SendMMS is a convenience wrapper around Create for a message with one or
+// more media attachments.
+func (s *MessageService) SendMMS(from, to PhoneNumber, body string, mediaURL ...string) (*Message, error) {
+	return s.Create(from, to, MessageParams{Body: body, MediaURL: mediaURL})
+}
+
+// Get fetches the message with the given sid.
+func (s *MessageService) Get(sid string) (*Message, error) {
+	msg := new(Message)
+	err := s.client.request(http.MethodGet, "Messages/"+sid+".json", nil, msg)
+	return msg, err
+}
+
+// Update modifies the message with the given sid. Twilio currently only
+// allows this to redact a message's Body.
+func (s *MessageService) Update(sid string, params MessageParams) (*Message, error) {
+	msg := new(Message)
+	err := s.client.request(http.MethodPost, "Messages/"+sid+".json", params.values(), msg)
+	return msg, err
+}
+
+// Delete removes the message with the given sid.
+func (s *MessageService) Delete(sid string) error {
+	return s.client.request(http.MethodDelete, "Messages/"+sid+".json", nil, nil)
+}
+
+// MessagePage is a single page of results from the Messages list resource.
+type MessagePage struct {
+	Page
+	Messages []*Message `json:"messages"`
+}
+
+// GetPage fetches a single page of messages matching params.
+func (s *MessageService) GetPage(params url.Values) (*MessagePage, error) {
+	page := new(MessagePage)
+	err := s.client.request(http.MethodGet, "Messages.json", params, page)
+	return page, err
+}
+
+// Iter returns an iterator over every message matching params, following
+// Twilio's next_page_uri cursors one page at a time.
+func (s *MessageService) Iter(params url.Values) *MessagePageIterator {
+	return &MessagePageIterator{client: s.client, params: params}
+}
+
+// MessagePageIterator walks the Messages list resource page by page.
+type MessagePageIterator struct {
+	client      *Client
+	params      url.Values
+	nextPageURI string
+	started     bool
+}
+
+// Next fetches the next page of messages, or io.EOF once the list is
+// exhausted.
+func (it *MessagePageIterator) Next() (*MessagePage, error) {
+	if it.started && it.nextPageURI == "" {
+		return nil, io.EOF
+	}
+	page := new(MessagePage)
+	var err error
+	if !it.started {
+		err = it.client.request(http.MethodGet, "Messages.json", it.params, page)
+	} else {
+		err = it.client.requestURL(http.MethodGet, it.client.baseURL()+it.nextPageURI, nil, page)
+	}
+	if err != nil {
+		return nil, err
+	}
+	it.started = true
+	it.nextPageURI = page.NextPageURI
+	return page, nil
+}
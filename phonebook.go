@@ -0,0 +1,156 @@
+package twilio
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"sync"
+)
+
+// Equal reports whether pn and other parse to the same underlying number,
+// rather than comparing their string representations directly. Use it when
+// comparing a number a user typed against one already on file, since the
+// two may have arrived in different formats.
+func (pn PhoneNumber) Equal(other PhoneNumber) bool {
+	a, err := NewPhoneNumber(string(pn))
+	if err != nil {
+		a = pn
+	}
+	b, err := NewPhoneNumber(string(other))
+	if err != nil {
+		b = other
+	}
+	return a == b
+}
+
+// Entry is a single phone number/alias pair, used when listing or
+// persisting a PhoneBook.
+type Entry struct {
+	Number PhoneNumber `json:"number" toml:"number"`
+	Alias  string      `json:"alias" toml:"alias"`
+}
+
+// PhoneBook is a bidirectional, concurrency-safe map between phone numbers
+// and caller-assigned aliases. Look a number up by its alias, or an alias
+// up by its number, without keeping two maps in sync by hand.
+type PhoneBook struct {
+	mu       sync.RWMutex
+	byNumber map[PhoneNumber]string
+	byAlias  map[string]PhoneNumber
+}
+
+// NewPhoneBook returns an empty PhoneBook.
+func NewPhoneBook() *PhoneBook {
+	return &PhoneBook{
+		byNumber: make(map[PhoneNumber]string),
+		byAlias:  make(map[string]PhoneNumber),
+	}
+}
+
+// Add associates number with alias, replacing any existing entry for
+// either.
+func (pb *PhoneBook) Add(number PhoneNumber, alias string) {
+	pb.mu.Lock()
+	defer pb.mu.Unlock()
+	if oldAlias, ok := pb.byNumber[number]; ok {
+		delete(pb.byAlias, oldAlias)
+	}
+	if oldNumber, ok := pb.byAlias[alias]; ok {
+		delete(pb.byNumber, oldNumber)
+	}
+	pb.byNumber[number] = alias
+	pb.byAlias[alias] = number
+}
+
+// Remove removes the entry for number, if any.
+func (pb *PhoneBook) Remove(number PhoneNumber) {
+	pb.mu.Lock()
+	defer pb.mu.Unlock()
+	alias, ok := pb.byNumber[number]
+	if !ok {
+		return
+	}
+	delete(pb.byNumber, number)
+	delete(pb.byAlias, alias)
+}
+
+// LookupByNumber returns the alias associated with number, if any.
+func (pb *PhoneBook) LookupByNumber(number PhoneNumber) (string, bool) {
+	pb.mu.RLock()
+	defer pb.mu.RUnlock()
+	alias, ok := pb.byNumber[number]
+	return alias, ok
+}
+
+// LookupByAlias returns the phone number associated with alias, if any.
+func (pb *PhoneBook) LookupByAlias(alias string) (PhoneNumber, bool) {
+	pb.mu.RLock()
+	defer pb.mu.RUnlock()
+	number, ok := pb.byAlias[alias]
+	return number, ok
+}
+
+// All returns every entry in the phone book, in no particular order.
+func (pb *PhoneBook) All() []Entry {
+	pb.mu.RLock()
+	defer pb.mu.RUnlock()
+	entries := make([]Entry, 0, len(pb.byNumber))
+	for number, alias := range pb.byNumber {
+		entries = append(entries, Entry{Number: number, Alias: alias})
+	}
+	return entries
+}
+
+// Load replaces the phone book's contents with entries, discarding whatever
+// was there before. It's exported so that other persistence formats, such
+// as the phonebooktoml subpackage, can rebuild a PhoneBook without reaching into its
+// internals.
+func (pb *PhoneBook) Load(entries []Entry) {
+	pb.mu.Lock()
+	defer pb.mu.Unlock()
+	pb.byNumber = make(map[PhoneNumber]string, len(entries))
+	pb.byAlias = make(map[string]PhoneNumber, len(entries))
+	for _, e := range entries {
+		pb.byNumber[e.Number] = e.Alias
+		pb.byAlias[e.Alias] = e.Number
+	}
+}
+
+// phoneBookFile is the on-disk JSON representation of a PhoneBook.
+type phoneBookFile struct {
+	Entries []Entry `json:"entries"`
+}
+
+// MarshalJSON encodes the phone book's entries as JSON.
+func (pb *PhoneBook) MarshalJSON() ([]byte, error) {
+	return json.Marshal(phoneBookFile{Entries: pb.All()})
+}
+
+// UnmarshalJSON replaces the phone book's contents with the entries decoded
+// from JSON.
+func (pb *PhoneBook) UnmarshalJSON(b []byte) error {
+	var f phoneBookFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+	pb.Load(f.Entries)
+	return nil
+}
+
+// SaveJSON writes the phone book to path as JSON.
+func (pb *PhoneBook) SaveJSON(path string) error {
+	b, err := json.MarshalIndent(pb, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+// LoadJSON replaces the phone book's contents with the entries read from
+// the JSON file at path.
+func (pb *PhoneBook) LoadJSON(path string) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, pb)
+}
@@ -0,0 +1,38 @@
+// Package phonebooktoml adds TOML persistence for a twilio.PhoneBook. It's
+// split out from the twilio package itself so that pulling in a REST client
+// or a TwiML handler doesn't also pull in a TOML parser.
+package phonebooktoml
+
+import (
+	"os"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/breinhoh/twilio-go"
+)
+
+// file is the on-disk TOML representation of a PhoneBook.
+type file struct {
+	Entries []twilio.Entry `toml:"entries"`
+}
+
+// Save writes pb's entries to path as TOML.
+func Save(pb *twilio.PhoneBook, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return toml.NewEncoder(f).Encode(file{Entries: pb.All()})
+}
+
+// Load replaces pb's contents with the entries read from the TOML file at
+// path.
+func Load(pb *twilio.PhoneBook, path string) error {
+	var f file
+	if _, err := toml.DecodeFile(path, &f); err != nil {
+		return err
+	}
+	pb.Load(f.Entries)
+	return nil
+}
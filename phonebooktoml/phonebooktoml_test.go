@@ -0,0 +1,48 @@
+package phonebooktoml
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/breinhoh/twilio-go"
+)
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	pb := twilio.NewPhoneBook()
+	pb.Add(twilio.PhoneNumber("+14155551234"), "bob")
+	pb.Add(twilio.PhoneNumber("+14155554321"), "alice")
+
+	path := filepath.Join(t.TempDir(), "phonebook.toml")
+	if err := Save(pb, path); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	// The on-disk keys must match the lowercase keys the JSON persistence
+	// uses, not the Go field names.
+	if !strings.Contains(string(b), "number =") || !strings.Contains(string(b), "alias =") {
+		t.Fatalf("saved TOML = %q, want lowercase number/alias keys", string(b))
+	}
+	if strings.Contains(string(b), "Number =") || strings.Contains(string(b), "Alias =") {
+		t.Fatalf("saved TOML = %q, want no capitalized Number/Alias keys", string(b))
+	}
+
+	loaded := twilio.NewPhoneBook()
+	if err := Load(loaded, path); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	alias, ok := loaded.LookupByNumber(twilio.PhoneNumber("+14155551234"))
+	if !ok || alias != "bob" {
+		t.Errorf("LookupByNumber(+14155551234) = %q, %v, want %q, true", alias, ok, "bob")
+	}
+	number, ok := loaded.LookupByAlias("alice")
+	if !ok || number != twilio.PhoneNumber("+14155554321") {
+		t.Errorf("LookupByAlias(alice) = %q, %v, want %q, true", number, ok, "+14155554321")
+	}
+}
@@ -0,0 +1,119 @@
+package twilio
+
+import "github.com/ttacon/libphonenumber"
+
+// NumberType classifies a phone number by how it's provisioned - mobile,
+// fixed line, VoIP, and so on - as reported by libphonenumber.
+type NumberType int
+
+const (
+	NumberTypeUnknown NumberType = iota
+	NumberTypeFixedLine
+	NumberTypeMobile
+	NumberTypeFixedLineOrMobile
+	NumberTypeTollFree
+	NumberTypePremiumRate
+	NumberTypeSharedCost
+	NumberTypeVoIP
+	NumberTypePersonalNumber
+	NumberTypePager
+	NumberTypeUAN
+	NumberTypeVoicemail
+)
+
+// parse parses pn, falling back to defaultRegion for numbers that don't
+// carry their own country code. Since NewPhoneNumber always stores numbers
+// in E.164 format, the default region only matters for a PhoneNumber value
+// that was constructed some other way.
+func (pn PhoneNumber) parse(defaultRegion string) (*libphonenumber.PhoneNumber, error) {
+	return libphonenumber.Parse(string(pn), defaultRegion)
+}
+
+// Type classifies pn as mobile, fixed line, VoIP, toll-free, and so on. It
+// returns NumberTypeUnknown if pn cannot be parsed.
+func (pn PhoneNumber) Type() NumberType {
+	num, err := pn.parse("US")
+	if err != nil {
+		return NumberTypeUnknown
+	}
+	switch libphonenumber.GetNumberType(num) {
+	case libphonenumber.FIXED_LINE:
+		return NumberTypeFixedLine
+	case libphonenumber.MOBILE:
+		return NumberTypeMobile
+	case libphonenumber.FIXED_LINE_OR_MOBILE:
+		return NumberTypeFixedLineOrMobile
+	case libphonenumber.TOLL_FREE:
+		return NumberTypeTollFree
+	case libphonenumber.PREMIUM_RATE:
+		return NumberTypePremiumRate
+	case libphonenumber.SHARED_COST:
+		return NumberTypeSharedCost
+	case libphonenumber.VOIP:
+		return NumberTypeVoIP
+	case libphonenumber.PERSONAL_NUMBER:
+		return NumberTypePersonalNumber
+	case libphonenumber.PAGER:
+		return NumberTypePager
+	case libphonenumber.UAN:
+		return NumberTypeUAN
+	case libphonenumber.VOICEMAIL:
+		return NumberTypeVoicemail
+	default:
+		return NumberTypeUnknown
+	}
+}
+
+// Region returns the ISO 3166-1 alpha-2 region code pn is registered in, or
+// "" if pn cannot be parsed.
+func (pn PhoneNumber) Region() string {
+	num, err := pn.parse("US")
+	if err != nil {
+		return ""
+	}
+	return libphonenumber.GetRegionCodeForNumber(num)
+}
+
+// CountryCode returns pn's numeric country calling code, e.g. 1 for a North
+// American number, or 0 if pn cannot be parsed.
+func (pn PhoneNumber) CountryCode() int {
+	num, err := pn.parse("US")
+	if err != nil {
+		return 0
+	}
+	return int(num.GetCountryCode())
+}
+
+// IsValid reports whether pn is a valid, dialable phone number.
+func (pn PhoneNumber) IsValid() bool {
+	num, err := pn.parse("US")
+	if err != nil {
+		return false
+	}
+	return libphonenumber.IsValidNumber(num)
+}
+
+// NewPhoneNumberInRegion parses pn as a phone number, treating it as a
+// national number in defaultRegion (an ISO 3166-1 alpha-2 code, e.g. "GB")
+// if pn doesn't carry its own country code. As with NewPhoneNumber, the
+// result is stored in E.164 format.
+func NewPhoneNumberInRegion(pn, defaultRegion string) (PhoneNumber, error) {
+	if len(pn) == 0 {
+		return "", ErrEmptyNumber
+	}
+	num, err := libphonenumber.Parse(pn, defaultRegion)
+	if err != nil {
+		return "", friendlyParseError(pn, err)
+	}
+	return PhoneNumber(libphonenumber.Format(num, libphonenumber.E164)), nil
+}
+
+// RFC3966 returns pn formatted as a "tel:" URI, e.g.
+// "tel:+1-410-555-4092". If pn cannot be parsed, it is returned as is.
+func (pn PhoneNumber) RFC3966() string {
+	num, err := pn.parse("US")
+	if err != nil {
+		return string(pn)
+	}
+	return libphonenumber.Format(num, libphonenumber.RFC3966)
+}
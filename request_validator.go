@@ -0,0 +1,125 @@
+package twilio
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// RequestValidator checks that an inbound webhook request really came from
+// Twilio, by verifying its X-Twilio-Signature header against the account's
+// auth token.
+type RequestValidator struct {
+	AuthToken string
+}
+
+// NewRequestValidator returns a RequestValidator that checks signatures
+// using authToken.
+func NewRequestValidator(authToken string) RequestValidator {
+	return RequestValidator{AuthToken: authToken}
+}
+
+// ValidateRequest reports whether signature is the correct Twilio signature
+// for a request to reqURL carrying params. For a form-encoded POST, params
+// should hold the request's POST parameters; for a GET request, pass nil.
+func ValidateRequest(authToken, signature, reqURL string, params url.Values) bool {
+	expected := []byte(expectedSignature(authToken, reqURL, params))
+	return hmac.Equal([]byte(signature), expected)
+}
+
+// expectedSignature implements Twilio's request signing scheme: the full
+// URL, followed by the sorted key/value pairs of params concatenated with
+// no separator, HMAC-SHA1'd using authToken as the key and base64-encoded.
+func expectedSignature(authToken, reqURL string, params url.Values) string {
+	data := reqURL
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		data += k + params.Get(k)
+	}
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(data))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ValidateBody reports whether signature is the correct Twilio signature for
+// a request to reqURL carrying a JSON body. reqURL must be the full request
+// URL including the bodySHA256 query parameter Twilio appends for JSON
+// webhooks; ValidateBody rejects the request if body's SHA-256 hash doesn't
+// match that parameter before checking the signature itself.
+func ValidateBody(authToken, signature, reqURL string, body []byte) bool {
+	parsed, err := url.Parse(reqURL)
+	if err != nil {
+		return false
+	}
+	want := parsed.Query().Get("bodySHA256")
+	if want == "" {
+		return false
+	}
+	sum := sha256.Sum256(body)
+	if hex.EncodeToString(sum[:]) != want {
+		return false
+	}
+	return ValidateRequest(authToken, signature, reqURL, nil)
+}
+
+// Validate reports whether req carries a valid X-Twilio-Signature header.
+// It reconstructs the request's full URL, reads and restores its body if
+// necessary, and dispatches to ValidateRequest or ValidateBody depending on
+// the request's method and content type.
+func (v RequestValidator) Validate(req *http.Request) bool {
+	signature := req.Header.Get("X-Twilio-Signature")
+	if signature == "" {
+		return false
+	}
+	reqURL := requestURL(req)
+	if req.Method == http.MethodGet {
+		return ValidateRequest(v.AuthToken, signature, reqURL, nil)
+	}
+	if ct := req.Header.Get("Content-Type"); strings.HasPrefix(ct, "application/json") {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return false
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		return ValidateBody(v.AuthToken, signature, reqURL, body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return false
+	}
+	return ValidateRequest(v.AuthToken, signature, reqURL, req.PostForm)
+}
+
+// requestURL reconstructs the full URL Twilio would have signed for req.
+func requestURL(req *http.Request) string {
+	scheme := "http"
+	if req.TLS != nil || req.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + req.Host + req.URL.RequestURI()
+}
+
+// ValidatingHandler wraps next so that requests without a valid
+// X-Twilio-Signature header for authToken are rejected with 403 Forbidden
+// before reaching it.
+func ValidatingHandler(authToken string, next http.Handler) http.Handler {
+	v := NewRequestValidator(authToken)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !v.Validate(r) {
+			http.Error(w, "invalid twilio signature", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,65 @@
+package twilio
+
+import (
+	"net/url"
+	"testing"
+)
+
+// testRequestURL and testRequestParams are Twilio's own published example
+// from the "Validating Requests" guide; testSignature is the HMAC-SHA1
+// signature their documented algorithm produces for that auth token, URL,
+// and parameter set.
+const (
+	testAuthToken  = "12345"
+	testRequestURL = "https://mycompany.com/myapp.php?foo=1&bar=2"
+	testSignature  = "HKH1PCdmw1YvcFsuJxOIA8Dzg2k="
+)
+
+func testRequestParams() url.Values {
+	return url.Values{
+		"CallSid": {"CA1234567890ABCDE1234567890ABCDE"},
+		"Caller":  {"+14158675310"},
+		"Digits":  {"1234"},
+		"From":    {"+14158675310"},
+		"To":      {"+18005551212"},
+	}
+}
+
+func TestValidateRequest(t *testing.T) {
+	if !ValidateRequest(testAuthToken, testSignature, testRequestURL, testRequestParams()) {
+		t.Fatal("expected the reference signature to validate")
+	}
+}
+
+func TestValidateRequestWrongToken(t *testing.T) {
+	if ValidateRequest("wrong-token", testSignature, testRequestURL, testRequestParams()) {
+		t.Fatal("expected validation to fail with the wrong auth token")
+	}
+}
+
+func TestValidateRequestTamperedParams(t *testing.T) {
+	params := testRequestParams()
+	params.Set("Digits", "9999")
+	if ValidateRequest(testAuthToken, testSignature, testRequestURL, params) {
+		t.Fatal("expected validation to fail once a signed parameter changes")
+	}
+}
+
+func TestValidateRequestWrongURL(t *testing.T) {
+	if ValidateRequest(testAuthToken, testSignature, testRequestURL+"&baz=3", testRequestParams()) {
+		t.Fatal("expected validation to fail once the URL changes")
+	}
+}
+
+func TestValidateBody(t *testing.T) {
+	body := []byte(`{"hello":"world"}`)
+	const bodySHA256 = "93a23971a914e5eacbf0a8d25154cda309c3c1c72fbb9914d47c60f3cb681588"
+	reqURL := "https://mycompany.com/myapp.php?bodySHA256=" + bodySHA256
+	signature := expectedSignature(testAuthToken, reqURL, nil)
+	if !ValidateBody(testAuthToken, signature, reqURL, body) {
+		t.Fatal("expected a correctly-signed JSON body to validate")
+	}
+	if ValidateBody(testAuthToken, signature, reqURL, []byte(`{"hello":"mars"}`)) {
+		t.Fatal("expected validation to fail once the body no longer matches bodySHA256")
+	}
+}
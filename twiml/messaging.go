@@ -0,0 +1,45 @@
+package twiml
+
+import "encoding/xml"
+
+// MessagingResponse is the root of a TwiML document returned from a
+// messaging webhook. Append verbs to it in the order they should be
+// executed and call Marshal to produce the XML Twilio expects.
+type MessagingResponse struct {
+	XMLName xml.Name `xml:"Response"`
+	Verbs   []Verb
+}
+
+// Append adds one or more verbs to the response, in order, and returns the
+// response so calls can be chained.
+func (r *MessagingResponse) Append(verbs ...Verb) *MessagingResponse {
+	r.Verbs = append(r.Verbs, verbs...)
+	return r
+}
+
+// Marshal renders the response as a TwiML document.
+func (r *MessagingResponse) Marshal() ([]byte, error) {
+	return marshal(r)
+}
+
+// Message sends an SMS or MMS message. Body is the text of the message;
+// Media verbs nested inside it attach media to an MMS message.
+type Message struct {
+	XMLName xml.Name `xml:"Message"`
+	To      string   `xml:"to,attr,omitempty"`
+	From    string   `xml:"from,attr,omitempty"`
+	Action  string   `xml:"action,attr,omitempty"`
+	Method  string   `xml:"method,attr,omitempty"`
+	Body    string   `xml:"Body,omitempty"`
+	Media   []*Media
+}
+
+func (*Message) verb() {}
+
+// Media attaches a media file, given by URL, to a Message.
+type Media struct {
+	XMLName xml.Name `xml:"Media"`
+	URL     string   `xml:",chardata"`
+}
+
+func (*Media) verb() {}
@@ -0,0 +1,78 @@
+// Package twiml provides types for building TwiML documents, the XML
+// response format Twilio expects from voice and messaging webhooks.
+//
+// Build a response by creating a VoiceResponse or MessagingResponse,
+// appending verbs to it, and calling Marshal:
+//
+//	resp := new(twiml.VoiceResponse)
+//	resp.Append(&twiml.Say{Text: "Hello there", Voice: "alice"})
+//	b, err := resp.Marshal()
+//
+// Or write it straight to an http.ResponseWriter with Write.
+package twiml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"net/http"
+)
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8"?>` + "\n"
+
+// A Verb is a single TwiML instruction - <Say>, <Dial>, <Gather>, and so on -
+// that can appear as a child of a Response.
+type Verb interface {
+	verb()
+}
+
+// A Response is a TwiML document root - VoiceResponse or MessagingResponse -
+// that can be marshaled and sent back to Twilio.
+type Response interface {
+	Marshal() ([]byte, error)
+}
+
+// marshal renders root as a complete TwiML document, with the leading XML
+// declaration Twilio requires.
+func marshal(root interface{}) ([]byte, error) {
+	b, err := xml.Marshal(root)
+	if err != nil {
+		return nil, err
+	}
+	buf := bytes.NewBufferString(xmlHeader)
+	buf.Write(b)
+	return buf.Bytes(), nil
+}
+
+// Write marshals resp and writes it to w, setting the Content-Type header
+// Twilio expects a TwiML webhook response to carry.
+func Write(w http.ResponseWriter, resp Response) error {
+	b, err := resp.Marshal()
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	_, err = w.Write(b)
+	return err
+}
+
+// Handler returns an http.Handler that writes resp as the response to every
+// request it serves, via Write. It's a convenience for webhook handlers
+// that always return the same static TwiML, such as an unconditional
+// Hangup.
+func Handler(resp Response) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if err := Write(w, resp); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// Redirect transfers control of a call or message to the TwiML at URL. It is
+// valid in both a VoiceResponse and a MessagingResponse.
+type Redirect struct {
+	XMLName xml.Name `xml:"Redirect"`
+	Method  string   `xml:"method,attr,omitempty"`
+	URL     string   `xml:",chardata"`
+}
+
+func (*Redirect) verb() {}
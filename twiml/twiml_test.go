@@ -0,0 +1,142 @@
+package twiml
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// testResponseWriter is a minimal http.ResponseWriter for exercising
+// Handler without pulling in net/http/httptest.
+type testResponseWriter struct {
+	header     http.Header
+	body       bytes.Buffer
+	statusCode int
+}
+
+func (w *testResponseWriter) Header() http.Header { return w.header }
+
+func (w *testResponseWriter) Write(b []byte) (int, error) { return w.body.Write(b) }
+
+func (w *testResponseWriter) WriteHeader(statusCode int) { w.statusCode = statusCode }
+
+func TestVoiceResponseMarshal(t *testing.T) {
+	resp := new(VoiceResponse)
+	resp.Append(
+		&Gather{
+			Action: "/gather?foo=1&bar=2",
+			Verbs: []Verb{
+				&Say{Text: `Press 1 if you're "done" & 2 if not < 0`},
+			},
+		},
+		&Dial{
+			Nouns: []Verb{
+				&Number{Text: "+14155551234"},
+			},
+		},
+	)
+	b, err := resp.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(b)
+
+	if !strings.HasPrefix(got, xmlHeader) {
+		t.Errorf("Marshal() = %q, want it to start with the XML declaration", got)
+	}
+	if !strings.Contains(got, "<Response>") || !strings.Contains(got, "</Response>") {
+		t.Errorf("Marshal() = %q, want a <Response> root element", got)
+	}
+
+	// The Gather's action URL is an attribute value; its "&" must be escaped
+	// so the document stays well-formed.
+	if strings.Contains(got, `action="/gather?foo=1&bar=2"`) {
+		t.Errorf("Marshal() = %q, want the Gather action attribute to escape &", got)
+	}
+	if !strings.Contains(got, `action="/gather?foo=1&amp;bar=2"`) {
+		t.Errorf("Marshal() = %q, want an escaped Gather action attribute", got)
+	}
+
+	// The Say text is chardata; <, &, and " must all come back escaped.
+	if strings.Contains(got, `<Say>Press 1 if you're "done" & 2 if not < 0</Say>`) {
+		t.Errorf("Marshal() = %q, want the Say text to be escaped", got)
+	}
+	if !strings.Contains(got, "&lt;") || !strings.Contains(got, "&amp;") || !strings.Contains(got, "&#34;") {
+		t.Errorf("Marshal() = %q, want the Say text's <, &, and \" all escaped", got)
+	}
+
+	// The Say verb must nest inside the Gather, not sit alongside it.
+	if !strings.Contains(got, "<Gather") {
+		t.Errorf("Marshal() = %q, want a <Gather> element", got)
+	}
+	gatherStart := strings.Index(got, "<Gather")
+	gatherEnd := strings.Index(got, "</Gather>")
+	sayIndex := strings.Index(got, "<Say>")
+	if gatherStart == -1 || gatherEnd == -1 || sayIndex == -1 || !(gatherStart < sayIndex && sayIndex < gatherEnd) {
+		t.Errorf("Marshal() = %q, want <Say> nested inside <Gather>...</Gather>", got)
+	}
+
+	// The Number noun must nest inside the Dial.
+	dialStart := strings.Index(got, "<Dial")
+	dialEnd := strings.Index(got, "</Dial>")
+	numberIndex := strings.Index(got, "<Number>")
+	if dialStart == -1 || dialEnd == -1 || numberIndex == -1 || !(dialStart < numberIndex && numberIndex < dialEnd) {
+		t.Errorf("Marshal() = %q, want <Number> nested inside <Dial>...</Dial>", got)
+	}
+}
+
+func TestMessagingResponseMarshal(t *testing.T) {
+	resp := new(MessagingResponse)
+	resp.Append(&Message{
+		Body:  `Tom & Jerry say "hi" <there>`,
+		Media: []*Media{{URL: "https://example.com/cat.png?a=1&b=2"}},
+	})
+	b, err := resp.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(b)
+
+	if !strings.HasPrefix(got, xmlHeader) {
+		t.Errorf("Marshal() = %q, want it to start with the XML declaration", got)
+	}
+
+	if strings.Contains(got, `Tom & Jerry say "hi" <there>`) {
+		t.Errorf("Marshal() = %q, want the message Body to be escaped", got)
+	}
+	if !strings.Contains(got, "Tom &amp; Jerry") || !strings.Contains(got, "&lt;there&gt;") {
+		t.Errorf("Marshal() = %q, want the message Body's & and <> escaped", got)
+	}
+
+	// The Media URL's "&" must be escaped as chardata too.
+	if strings.Contains(got, "cat.png?a=1&b=2") {
+		t.Errorf("Marshal() = %q, want the Media URL to escape &", got)
+	}
+	if !strings.Contains(got, "cat.png?a=1&amp;b=2") {
+		t.Errorf("Marshal() = %q, want an escaped Media URL", got)
+	}
+
+	// The Media verb must nest inside the Message.
+	msgStart := strings.Index(got, "<Message")
+	msgEnd := strings.Index(got, "</Message>")
+	mediaIndex := strings.Index(got, "<Media>")
+	if msgStart == -1 || msgEnd == -1 || mediaIndex == -1 || !(msgStart < mediaIndex && mediaIndex < msgEnd) {
+		t.Errorf("Marshal() = %q, want <Media> nested inside <Message>...</Message>", got)
+	}
+}
+
+func TestHandlerWritesContentType(t *testing.T) {
+	resp := new(VoiceResponse)
+	resp.Append(&Hangup{})
+
+	rec := &testResponseWriter{header: make(map[string][]string)}
+	Handler(resp).ServeHTTP(rec, nil)
+
+	if ct := rec.header["Content-Type"]; len(ct) != 1 || ct[0] != "application/xml" {
+		t.Errorf("Content-Type header = %v, want [application/xml]", ct)
+	}
+	if !strings.Contains(rec.body.String(), "<Hangup></Hangup>") {
+		t.Errorf("body = %q, want it to contain <Hangup></Hangup>", rec.body.String())
+	}
+}
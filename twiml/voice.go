@@ -0,0 +1,133 @@
+package twiml
+
+import "encoding/xml"
+
+// VoiceResponse is the root of a TwiML document returned from a voice
+// webhook. Append verbs to it in the order they should be executed and call
+// Marshal to produce the XML Twilio expects.
+type VoiceResponse struct {
+	XMLName xml.Name `xml:"Response"`
+	Verbs   []Verb
+}
+
+// Append adds one or more verbs to the response, in order, and returns the
+// response so calls can be chained.
+func (r *VoiceResponse) Append(verbs ...Verb) *VoiceResponse {
+	r.Verbs = append(r.Verbs, verbs...)
+	return r
+}
+
+// Marshal renders the response as a TwiML document.
+func (r *VoiceResponse) Marshal() ([]byte, error) {
+	return marshal(r)
+}
+
+// Say converts text to speech and plays it back to the caller.
+type Say struct {
+	XMLName  xml.Name `xml:"Say"`
+	Voice    string   `xml:"voice,attr,omitempty"`
+	Language string   `xml:"language,attr,omitempty"`
+	Loop     int      `xml:"loop,attr,omitempty"`
+	Text     string   `xml:",chardata"`
+}
+
+func (*Say) verb() {}
+
+// Play plays an audio file back to the caller. URL is the location of the
+// file to play.
+type Play struct {
+	XMLName xml.Name `xml:"Play"`
+	Loop    int      `xml:"loop,attr,omitempty"`
+	Digits  string   `xml:"digits,attr,omitempty"`
+	URL     string   `xml:",chardata"`
+}
+
+func (*Play) verb() {}
+
+// Pause waits silently for Length seconds before moving to the next verb.
+type Pause struct {
+	XMLName xml.Name `xml:"Pause"`
+	Length  int      `xml:"length,attr,omitempty"`
+}
+
+func (*Pause) verb() {}
+
+// Gather collects digits, or speech, that a caller types on their keypad and
+// sends them to the URL given in Action. Say, Play, and Pause verbs nested
+// inside a Gather are played while Twilio waits for input.
+type Gather struct {
+	XMLName     xml.Name `xml:"Gather"`
+	Input       string   `xml:"input,attr,omitempty"`
+	Action      string   `xml:"action,attr,omitempty"`
+	Method      string   `xml:"method,attr,omitempty"`
+	Timeout     int      `xml:"timeout,attr,omitempty"`
+	FinishOnKey string   `xml:"finishOnKey,attr,omitempty"`
+	NumDigits   int      `xml:"numDigits,attr,omitempty"`
+	Verbs       []Verb
+}
+
+func (*Gather) verb() {}
+
+// Dial connects the caller to another phone, conference, or SIP endpoint.
+// Number and Conference are the nouns this package provides for nesting
+// inside it; it has no Client or Sip noun type.
+type Dial struct {
+	XMLName  xml.Name `xml:"Dial"`
+	Action   string   `xml:"action,attr,omitempty"`
+	Method   string   `xml:"method,attr,omitempty"`
+	Timeout  int      `xml:"timeout,attr,omitempty"`
+	CallerID string   `xml:"callerId,attr,omitempty"`
+	Record   string   `xml:"record,attr,omitempty"`
+	// Text dials a phone number directly, without a nested noun such as
+	// Number or Conference.
+	Text  string `xml:",chardata"`
+	Nouns []Verb
+}
+
+func (*Dial) verb() {}
+
+// Number is a phone number to dial, nested inside a Dial.
+type Number struct {
+	XMLName xml.Name `xml:"Number"`
+	URL     string   `xml:"url,attr,omitempty"`
+	Method  string   `xml:"method,attr,omitempty"`
+	Text    string   `xml:",chardata"`
+}
+
+func (*Number) verb() {}
+
+// Conference connects the caller into a named conference room, nested
+// inside a Dial.
+type Conference struct {
+	XMLName      xml.Name `xml:"Conference"`
+	Muted        bool     `xml:"muted,attr,omitempty"`
+	Beep         string   `xml:"beep,attr,omitempty"`
+	StartOnEnter bool     `xml:"startConferenceOnEnter,attr,omitempty"`
+	EndOnExit    bool     `xml:"endConferenceOnExit,attr,omitempty"`
+	Text         string   `xml:",chardata"`
+}
+
+func (*Conference) verb() {}
+
+// Record records the caller's voice and, once complete, requests the TwiML
+// at Action.
+type Record struct {
+	XMLName            xml.Name `xml:"Record"`
+	Action             string   `xml:"action,attr,omitempty"`
+	Method             string   `xml:"method,attr,omitempty"`
+	Timeout            int      `xml:"timeout,attr,omitempty"`
+	FinishOnKey        string   `xml:"finishOnKey,attr,omitempty"`
+	MaxLength          int      `xml:"maxLength,attr,omitempty"`
+	PlayBeep           bool     `xml:"playBeep,attr,omitempty"`
+	Transcribe         bool     `xml:"transcribe,attr,omitempty"`
+	TranscribeCallback string   `xml:"transcribeCallback,attr,omitempty"`
+}
+
+func (*Record) verb() {}
+
+// Hangup ends the call.
+type Hangup struct {
+	XMLName xml.Name `xml:"Hangup"`
+}
+
+func (*Hangup) verb() {}
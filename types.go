@@ -24,18 +24,25 @@ func NewPhoneNumber(pn string) (PhoneNumber, error) {
 		return "", ErrEmptyNumber
 	}
 	num, err := libphonenumber.Parse(pn, "US")
-	// Add some better error messages - the ones in libphonenumber are generic
-	switch {
-	case err == libphonenumber.ErrNotANumber:
-		return "", fmt.Errorf("twilio: Invalid phone number: %s", pn)
-	case err == libphonenumber.ErrInvalidCountryCode:
-		return "", fmt.Errorf("twilio: Invalid country code for number: %s", pn)
-	case err != nil:
-		return "", err
+	if err != nil {
+		return "", friendlyParseError(pn, err)
 	}
 	return PhoneNumber(libphonenumber.Format(num, libphonenumber.E164)), nil
 }
 
+// friendlyParseError translates the generic errors libphonenumber.Parse
+// returns into messages that name the offending number.
+func friendlyParseError(pn string, err error) error {
+	switch err {
+	case libphonenumber.ErrNotANumber:
+		return fmt.Errorf("twilio: Invalid phone number: %s", pn)
+	case libphonenumber.ErrInvalidCountryCode:
+		return fmt.Errorf("twilio: Invalid country code for number: %s", pn)
+	default:
+		return err
+	}
+}
+
 // Friendly returns a friendly international representation of the phone
 // number, for example, "+14105554092" is returned as "+1 410-555-4092". If the
 // phone number is not in E.164 format, we try to parse it as a US number. If
@@ -146,44 +153,13 @@ func (tt *TwilioTime) MarshalJSON() ([]byte, error) {
 	return b, nil
 }
 
-var symbols = map[string]string{
-	"USD": "$",
-	"GBP": "£",
-	"JPY": "¥",
-	"MXN": "$",
-	"CHF": "CHF",
-	"CAD": "$",
-	"CNY": "¥",
-	"SGD": "$",
-	"EUR": "€",
-}
-
-// Price flips the sign of the amount and prints it with a currency symbol for
-// the given unit.
+// price flips the sign of amount and prints it with a currency symbol for
+// the given unit. Deprecated: use FormatPrice, which knows the symbols of
+// more currencies and supports locale-aware formatting. Its symbol table
+// does not cover every ISO 4217 code; a code missing from it is printed as
+// the code itself.
 func price(unit string, amount string) string {
-	if len(amount) == 0 {
-		return amount
-	}
-	if amount[0] == '-' {
-		amount = amount[1:]
-	} else {
-		amount = "-" + amount
-	}
-	for strings.Contains(amount, ".") && strings.HasSuffix(amount, "0") {
-		amount = amount[:len(amount)-1]
-	}
-	if strings.HasSuffix(amount, ".") {
-		amount = amount[:len(amount)-1]
-	}
-	unit = strings.ToUpper(unit)
-	if sym, ok := symbols[unit]; ok {
-		return sym + amount
-	} else {
-		if unit == "" {
-			return amount
-		}
-		return unit + " " + amount
-	}
+	return FormatPrice(unit, amount)
 }
 
 type TwilioDuration time.Duration